@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/github/hub/git"
 	"github.com/github/hub/github"
@@ -13,7 +14,7 @@ import (
 
 var cmdCreate = &Command{
 	Run:   create,
-	Usage: "create [-poc] [-d <DESCRIPTION>] [-h <HOMEPAGE>] [[<ORGANIZATION>/]<NAME>]",
+	Usage: "create [-poc] [-d <DESCRIPTION>] [-h <HOMEPAGE>] [-r <REMOTE>] [--mirror] [--import-url=<URL>] [--template=<OWNER>/<REPO>] [--gitignore=<TEMPLATE>] [--license=<KEY>] [--auto-init] [[<ORGANIZATION>/]<NAME>]",
 	Long: `Create a new repository on GitHub and add a git remote for it.
 
 ## Options:
@@ -33,6 +34,48 @@ var cmdCreate = &Command{
 	-c, --copy
 		Put the URL of the new repository to clipboard instead of printing it.
 
+	-r, --remote=<REMOTE>
+		Set the git remote name for the new repository (default: "origin").
+
+	--mirror
+		Add the remote with "--mirror=push", so that every push to it mirrors
+		all refs, and run "git push --mirror" once the remote has been added.
+		The remote's fetch refspec is left at git's default.
+
+	--import-url=<URL>
+		Import an existing repository from <URL> into the newly created GitHub
+		repository using GitHub's source import service. Progress is streamed
+		until the import completes.
+
+	--import-vcs=<VCS>
+		The version control system used at <URL>: one of "git", "svn", "hg" or
+		"tfvc". Only meaningful with --import-url. When omitted, GitHub detects
+		the VCS automatically.
+
+	--import-username=<USERNAME>, --import-password=<PASSWORD>
+		Credentials for <URL> when importing a private repository. Only
+		meaningful with --import-url.
+
+	--template=<OWNER>/<REPO>
+		Generate the new repository from the named template repository instead
+		of creating an empty one. With this option "create" may be run outside
+		of a git repository, in which case the result is cloned into the
+		current directory. When run this way, --remote renames the clone's
+		"origin" remote after the fact; --mirror isn't supported in this mode.
+		Inside a git repository, a remote for the result is added as usual.
+
+	--gitignore=<TEMPLATE>
+		Seed the new repository with a .gitignore template for the given
+		language (e.g. "Go"). Only meaningful when not using --template.
+
+	--license=<KEY>
+		Seed the new repository with the named open source license (e.g.
+		"mit"). Only meaningful when not using --template.
+
+	--auto-init
+		Create the new repository with an initial commit. Only meaningful
+		when not using --template.
+
 	[<ORGANIZATION>/]<NAME>
 		The name for the repository on GitHub (default: name of the current working
 		directory).
@@ -48,6 +91,15 @@ var cmdCreate = &Command{
 		[ repo created in GitHub organization ]
 		> git remote add -f origin git@github.com:sinatra/recipes.git
 
+		$ hub create --remote=github --mirror
+		[ repo created on GitHub ]
+		> git remote add --mirror=push github git@github.com:USER/REPO.git
+		> git push --mirror github
+
+		$ hub create --template=github/hub-template my-project
+		[ repo generated from github/hub-template ]
+		> git clone git@github.com:USER/my-project.git .
+
 ## See also:
 
 hub-init(1), hub(1)
@@ -57,10 +109,20 @@ hub-init(1), hub(1)
 var (
 	flagCreatePrivate,
 	flagCreateBrowse,
-	flagCreateCopy bool
+	flagCreateCopy,
+	flagCreateMirror,
+	flagCreateAutoInit bool
 
 	flagCreateDescription,
-	flagCreateHomepage string
+	flagCreateHomepage,
+	flagCreateRemote,
+	flagCreateImportURL,
+	flagCreateImportVCS,
+	flagCreateImportUsername,
+	flagCreateImportPassword,
+	flagCreateTemplate,
+	flagCreateGitignore,
+	flagCreateLicense string
 )
 
 func init() {
@@ -69,15 +131,28 @@ func init() {
 	cmdCreate.Flag.BoolVarP(&flagCreateCopy, "copy", "c", false, "COPY")
 	cmdCreate.Flag.StringVarP(&flagCreateDescription, "description", "d", "", "DESCRIPTION")
 	cmdCreate.Flag.StringVarP(&flagCreateHomepage, "homepage", "h", "", "HOMEPAGE")
+	cmdCreate.Flag.StringVarP(&flagCreateRemote, "remote", "r", "origin", "REMOTE")
+	cmdCreate.Flag.BoolVar(&flagCreateMirror, "mirror", false, "MIRROR")
+	cmdCreate.Flag.StringVar(&flagCreateImportURL, "import-url", "", "URL")
+	cmdCreate.Flag.StringVar(&flagCreateImportVCS, "import-vcs", "", "VCS")
+	cmdCreate.Flag.StringVar(&flagCreateImportUsername, "import-username", "", "USERNAME")
+	cmdCreate.Flag.StringVar(&flagCreateImportPassword, "import-password", "", "PASSWORD")
+	cmdCreate.Flag.StringVar(&flagCreateTemplate, "template", "", "OWNER/REPO")
+	cmdCreate.Flag.StringVar(&flagCreateGitignore, "gitignore", "", "TEMPLATE")
+	cmdCreate.Flag.StringVar(&flagCreateLicense, "license", "", "LICENSE")
+	cmdCreate.Flag.BoolVar(&flagCreateAutoInit, "auto-init", false, "AUTO_INIT")
 
 	CmdRunner.Use(cmdCreate)
 }
 
 func create(command *Command, args *Args) {
-	_, err := git.Dir()
-	if err != nil {
-		err = fmt.Errorf("'create' must be run from inside a git repository")
-		utils.Check(err)
+	var err error
+	if flagCreateTemplate == "" {
+		_, err = git.Dir()
+		if err != nil {
+			err = fmt.Errorf("'create' must be run from inside a git repository")
+			utils.Check(err)
+		}
 	}
 
 	var newRepoName string
@@ -120,6 +195,9 @@ func create(command *Command, args *Args) {
 			} else {
 				ui.Errorln("Existing repository detected")
 				project = foundProject
+				if flagCreateImportURL != "" {
+					ui.Errorf("warning: --import-url ignored, '%s' already exists\n", repo.FullName)
+				}
 			}
 		} else {
 			repo = nil
@@ -130,27 +208,143 @@ func create(command *Command, args *Args) {
 
 	if repo == nil {
 		if !args.Noop {
-			repo, err := gh.CreateRepository(project, flagCreateDescription, flagCreateHomepage, flagCreatePrivate)
+			var err error
+			if flagCreateTemplate != "" {
+				templateProject, e := parseTemplateProject(flagCreateTemplate, project.Host)
+				utils.Check(e)
+				repo, err = gh.CreateRepositoryFromTemplate(templateProject, project, flagCreatePrivate)
+			} else {
+				repo, err = gh.CreateRepository(project, flagCreateDescription, flagCreateHomepage, flagCreatePrivate, flagCreateGitignore, flagCreateLicense, flagCreateAutoInit)
+			}
 			utils.Check(err)
 			project = github.NewProject(repo.FullName, "", project.Host)
+
+			if flagCreateImportURL != "" {
+				utils.Check(importRepository(gh, project))
+			}
 		}
 	}
 
-	localRepo, err := github.LocalRepo()
-	utils.Check(err)
+	if flagCreateTemplate != "" {
+		if _, err := git.Dir(); err != nil {
+			if flagCreateMirror {
+				utils.Check(fmt.Errorf("--mirror can't be combined with --template outside of a git repository"))
+			}
+
+			url := project.GitURL("", "", true)
+			args.Before("git", "clone", url, ".")
+
+			remoteName := flagCreateRemote
+			if remoteName == "" {
+				remoteName = "origin"
+			}
+			if remoteName != "origin" {
+				// "git clone" always names its remote "origin"; rename it to
+				// match --remote now that the clone has run.
+				args.Before("git", "remote", "rename", "origin", remoteName)
+			}
 
-	originName := "origin"
-	if originRemote, err := localRepo.RemoteByName(originName); err == nil {
-		originProject, err := originRemote.Project()
-		if err != nil || !originProject.SameAs(project) {
-			ui.Errorf(`A git remote named "%s" already exists and is set to push to '%s'.\n`, originRemote.Name, originRemote.PushURL)
+			webUrl := project.WebURL("", "", "")
+			args.NoForward()
+			printBrowseOrCopy(args, webUrl, flagCreateBrowse, flagCreateCopy)
+			return
 		}
-	} else {
-		url := project.GitURL("", "", true)
-		args.Before("git", "remote", "add", "-f", originName, url)
 	}
 
+	localRepo, err := github.LocalRepo()
+	utils.Check(err)
+
+	addProjectRemote(localRepo, args, project, flagCreateRemote, flagCreateMirror)
+
 	webUrl := project.WebURL("", "", "")
 	args.NoForward()
 	printBrowseOrCopy(args, webUrl, flagCreateBrowse, flagCreateCopy)
 }
+
+// parseTemplateProject splits a "--template" argument of the form
+// OWNER/REPO into a github.Project on the given host.
+func parseTemplateProject(template, host string) (*github.Project, error) {
+	split := strings.SplitN(template, "/", 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("invalid --template argument: %s (expected OWNER/REPO)", template)
+	}
+
+	return github.NewProject(split[0], split[1], host), nil
+}
+
+// addProjectRemote wires up a git remote named remoteName pointing at
+// project, unless a remote by that name already exists. When mirror is set,
+// the remote is configured to mirror pushes and a "git push --mirror" is
+// queued right after it's added.
+func addProjectRemote(localRepo *github.GitHubRepo, args *Args, project *github.Project, remoteName string, mirror bool) {
+	if remoteName == "" {
+		remoteName = "origin"
+	}
+
+	if remote, err := localRepo.RemoteByName(remoteName); err == nil {
+		remoteProject, err := remote.Project()
+		if err != nil || !remoteProject.SameAs(project) {
+			ui.Errorf(`A git remote named "%s" already exists and is set to push to '%s'.\n`, remote.Name, remote.PushURL)
+		}
+		return
+	}
+
+	queueRemoteAdd(args, project, remoteName, mirror)
+}
+
+// queueRemoteAdd schedules the git commands that add remoteName as a remote
+// for project with the runner, without checking whether one already exists.
+// Use this instead of addProjectRemote when there's no local repository yet
+// to inspect, such as right after a fresh "git init".
+func queueRemoteAdd(args *Args, project *github.Project, remoteName string, mirror bool) {
+	for _, cmd := range remoteAddCommands(project, remoteName, mirror) {
+		args.Before(cmd[0], cmd[1:]...)
+	}
+}
+
+// remoteAddCommands returns the git command(s) needed to add remoteName
+// (defaulting to "origin") as a remote for project, honoring mirror.
+func remoteAddCommands(project *github.Project, remoteName string, mirror bool) [][]string {
+	if remoteName == "" {
+		remoteName = "origin"
+	}
+
+	url := project.GitURL("", "", true)
+	if mirror {
+		return [][]string{
+			{"git", "remote", "add", "--mirror=push", remoteName, url},
+			{"git", "push", "--mirror", remoteName},
+		}
+	}
+	return [][]string{{"git", "remote", "add", "-f", remoteName, url}}
+}
+
+// importRepository kicks off a source import of flagCreateImportURL into
+// project and blocks, printing progress, until the import finishes.
+func importRepository(gh *github.Client, project *github.Project) error {
+	pi, err := gh.StartImport(project, flagCreateImportVCS, flagCreateImportURL, flagCreateImportUsername, flagCreateImportPassword)
+	if err != nil {
+		return err
+	}
+
+	for pi.Status != "complete" && pi.Status != "error" {
+		if pi.StatusText != "" {
+			ui.Printf("Importing... %d%% (%d commits) - %s\n", pi.PercentComplete, pi.CommitCount, pi.StatusText)
+		} else {
+			ui.Printf("Importing... %d%% (%d commits)\n", pi.PercentComplete, pi.CommitCount)
+		}
+
+		time.Sleep(2 * time.Second)
+		pi, err = gh.ImportProgress(project)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.Status == "error" {
+		return fmt.Errorf("import of '%s' failed: %s", flagCreateImportURL, pi.Message)
+	}
+
+	ui.Printf("Import complete: %d commits\n", pi.CommitCount)
+	return nil
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/github/hub/github"
+)
+
+func TestRemoteAddCommands(t *testing.T) {
+	project := github.NewProject("octocat", "hello-world", "github.com")
+
+	cmds := remoteAddCommands(project, "", false)
+	want := [][]string{
+		{"git", "remote", "add", "-f", "origin", project.GitURL("", "", true)},
+	}
+	if !reflect.DeepEqual(cmds, want) {
+		t.Errorf("remoteAddCommands() = %#v, want %#v", cmds, want)
+	}
+}
+
+func TestRemoteAddCommandsCustomName(t *testing.T) {
+	project := github.NewProject("octocat", "hello-world", "github.com")
+
+	cmds := remoteAddCommands(project, "upstream", false)
+	want := [][]string{
+		{"git", "remote", "add", "-f", "upstream", project.GitURL("", "", true)},
+	}
+	if !reflect.DeepEqual(cmds, want) {
+		t.Errorf("remoteAddCommands() = %#v, want %#v", cmds, want)
+	}
+}
+
+func TestRemoteAddCommandsMirror(t *testing.T) {
+	project := github.NewProject("octocat", "hello-world", "github.com")
+
+	cmds := remoteAddCommands(project, "github", true)
+	want := [][]string{
+		{"git", "remote", "add", "--mirror=push", "github", project.GitURL("", "", true)},
+		{"git", "push", "--mirror", "github"},
+	}
+	if !reflect.DeepEqual(cmds, want) {
+		t.Errorf("remoteAddCommands() = %#v, want %#v", cmds, want)
+	}
+}
+
+func TestParseTemplateProject(t *testing.T) {
+	project, err := parseTemplateProject("octocat/Spoon-Knife", "github.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.Owner != "octocat" || project.Name != "Spoon-Knife" {
+		t.Errorf("parseTemplateProject() = %#v, want owner octocat, name Spoon-Knife", project)
+	}
+}
+
+func TestParseTemplateProjectInvalid(t *testing.T) {
+	for _, template := range []string{"", "no-slash", "/missing-owner", "missing-repo/"} {
+		if _, err := parseTemplateProject(template, "github.com"); err == nil {
+			t.Errorf("parseTemplateProject(%q) expected an error, got none", template)
+		}
+	}
+}
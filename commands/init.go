@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/github/hub/git"
+	"github.com/github/hub/github"
+	"github.com/github/hub/utils"
+)
+
+var cmdInit = &Command{
+	Run:   initRepo,
+	Usage: "init -g [-p] [-r <REMOTE>] [--mirror] [<DIRECTORY>]",
+	Long: `Create a new git repository in the current or named directory and,
+when -g is given, add a git remote pointing at the matching GitHub
+repository for the current user.
+
+## Options:
+	-g
+		Additionally set up the GitHub remote for the current project,
+		named like <DIRECTORY> (or like the current directory when no
+		<DIRECTORY> is given).
+
+	-p, --private
+		The GitHub remote added via -g should point at a private repository.
+
+	-r, --remote=<REMOTE>
+		Set the git remote name to use with -g (default: "origin").
+
+	--mirror
+		Add the -g remote with "--mirror=push", so that every push to it
+		mirrors all refs, and run "git push --mirror" once the remote has been
+		added. The remote's fetch refspec is left at git's default.
+
+## See also:
+
+hub-create(1), hub(1)
+`,
+}
+
+var (
+	flagInitG,
+	flagInitPrivate,
+	flagInitMirror bool
+
+	flagInitRemote string
+)
+
+func init() {
+	cmdInit.Flag.BoolVar(&flagInitG, "g", false, "GITHUB_REMOTE")
+	cmdInit.Flag.BoolVarP(&flagInitPrivate, "private", "p", false, "PRIVATE")
+	cmdInit.Flag.StringVarP(&flagInitRemote, "remote", "r", "origin", "REMOTE")
+	cmdInit.Flag.BoolVar(&flagInitMirror, "mirror", false, "MIRROR")
+
+	CmdRunner.Use(cmdInit)
+}
+
+func initRepo(command *Command, args *Args) {
+	initArgs := initCommand(args.Params)
+	args.Before(initArgs[0], initArgs[1:]...)
+	args.NoForward()
+
+	if !flagInitG {
+		return
+	}
+
+	newRepoName := github.SanitizeProjectName(initDirName(args.Params))
+
+	config := github.CurrentConfig()
+	host, err := config.DefaultHost()
+	if err != nil {
+		utils.Check(github.FormatError("initializing repository", err))
+	}
+
+	project := github.NewProject(host.User, newRepoName, host.Host)
+	gh := github.NewClient(project.Host)
+
+	repo, err := gh.Repository(project)
+	if err == nil {
+		foundProject := github.NewProject(repo.FullName, "", project.Host)
+		if foundProject.SameAs(project) {
+			project = foundProject
+		} else {
+			repo = nil
+		}
+	} else {
+		repo = nil
+	}
+
+	if repo == nil {
+		repo, err = gh.CreateRepository(project, "", "", flagInitPrivate, "", "", false)
+		utils.Check(err)
+		project = github.NewProject(repo.FullName, "", project.Host)
+	}
+
+	// "git init" is only queued above, not yet run, so there's no local
+	// repository to inspect for existing remotes yet -- a freshly
+	// initialized repo can't have any.
+	queueRemoteAdd(args, project, flagInitRemote, flagInitMirror)
+}
+
+// initCommand returns the "git init" invocation to queue, forwarding along
+// any directory argument or native git flags the user passed to "hub init".
+func initCommand(params []string) []string {
+	return append([]string{"git", "init"}, params...)
+}
+
+// initDirName picks the target directory out of params (the last argument
+// that isn't itself a flag), falling back to the current working
+// directory's name when none was given.
+func initDirName(params []string) string {
+	dirName := ""
+	for _, param := range params {
+		if !strings.HasPrefix(param, "-") {
+			dirName = param
+		}
+	}
+	if dirName == "" {
+		wd, err := git.WorkdirName()
+		utils.Check(err)
+		dirName = wd
+	}
+	return dirName
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInitCommand(t *testing.T) {
+	got := initCommand(nil)
+	want := []string{"git", "init"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("initCommand(nil) = %#v, want %#v", got, want)
+	}
+
+	got = initCommand([]string{"myproject"})
+	want = []string{"git", "init", "myproject"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("initCommand([]string{\"myproject\"}) = %#v, want %#v", got, want)
+	}
+
+	got = initCommand([]string{"--bare", "myproject.git"})
+	want = []string{"git", "init", "--bare", "myproject.git"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("initCommand(...) = %#v, want %#v", got, want)
+	}
+}
+
+func TestInitDirName(t *testing.T) {
+	if got := initDirName([]string{"myproject"}); got != "myproject" {
+		t.Errorf("initDirName([]string{\"myproject\"}) = %q, want %q", got, "myproject")
+	}
+
+	if got := initDirName([]string{"--bare", "myproject.git"}); got != "myproject.git" {
+		t.Errorf("initDirName(...) = %q, want %q", got, "myproject.git")
+	}
+}
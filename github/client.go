@@ -0,0 +1,186 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Repository represents a GitHub repository as returned by the API.
+type Repository struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	Private  bool   `json:"private"`
+
+	HtmlURL  string `json:"html_url"`
+	CloneURL string `json:"clone_url"`
+	GitURL   string `json:"git_url"`
+	SshURL   string `json:"ssh_url"`
+}
+
+// Import tracks the state of a repository import started via
+// Client.StartImport, as reported by GitHub's Source Import API.
+type Import struct {
+	Status          string `json:"status"`
+	StatusText      string `json:"status_text"`
+	PercentComplete int    `json:"percent"`
+	CommitCount     int    `json:"commit_count"`
+	Message         string `json:"message"`
+}
+
+// Client talks to the GitHub API for a single host.
+type Client struct {
+	Host string
+}
+
+func NewClient(host string) *Client {
+	return &Client{Host: host}
+}
+
+// simpleApi builds an authenticated HTTP client for talking to the GitHub
+// API at client.Host, resolving credentials from the config entry that
+// actually matches client.Host (which may differ from the default host,
+// e.g. for a GitHub Enterprise project).
+func (client *Client) simpleApi() (c *simpleClient, err error) {
+	host := CurrentConfig().Find(client.Host)
+	if host == nil {
+		err = fmt.Errorf("could not find credentials for host %s; run `hub api` or `git config` to set one up", client.Host)
+		return
+	}
+
+	apiRoot := "https://api.github.com/"
+	if client.Host != "" && client.Host != "github.com" {
+		apiRoot = fmt.Sprintf("https://%s/api/v3/", client.Host)
+	}
+
+	root, err := url.Parse(apiRoot)
+	if err != nil {
+		return
+	}
+
+	c = &simpleClient{httpClient: &http.Client{}, rootURL: root, host: host}
+	return
+}
+
+func (client *Client) Repository(project *Project) (repo *Repository, err error) {
+	api, err := client.simpleApi()
+	if err != nil {
+		return
+	}
+
+	repo = &Repository{}
+	err = api.Get(fmt.Sprintf("repos/%s/%s", project.Owner, project.Name), repo)
+	return
+}
+
+func (client *Client) CreateRepository(project *Project, description, homepage string, isPrivate bool, gitignore, license string, autoInit bool) (repo *Repository, err error) {
+	params := createRepositoryParams(project, description, homepage, isPrivate, gitignore, license, autoInit)
+
+	api, err := client.simpleApi()
+	if err != nil {
+		return
+	}
+
+	repoURL := "user/repos"
+	if project.Owner != "" && project.Owner != api.host.User {
+		repoURL = fmt.Sprintf("orgs/%s/repos", project.Owner)
+	}
+
+	repo = &Repository{}
+	err = api.PostJSON(repoURL, params, repo)
+	return
+}
+
+// createRepositoryParams builds the POST /user/repos (or /orgs/.../repos)
+// request body for CreateRepository.
+func createRepositoryParams(project *Project, description, homepage string, isPrivate bool, gitignore, license string, autoInit bool) map[string]interface{} {
+	params := map[string]interface{}{
+		"name":        project.Name,
+		"description": description,
+		"homepage":    homepage,
+		"private":     isPrivate,
+	}
+	if gitignore != "" {
+		params["gitignore_template"] = gitignore
+	}
+	if license != "" {
+		params["license_template"] = license
+	}
+	if autoInit {
+		params["auto_init"] = true
+	}
+	return params
+}
+
+// CreateRepositoryFromTemplate generates a new repository for project from
+// templateProject using GitHub's repository template API.
+func (client *Client) CreateRepositoryFromTemplate(templateProject, project *Project, isPrivate bool) (repo *Repository, err error) {
+	params := createRepositoryFromTemplateParams(project, isPrivate)
+
+	generateURL := fmt.Sprintf("repos/%s/%s/generate", templateProject.Owner, templateProject.Name)
+	api, err := client.simpleApi()
+	if err != nil {
+		return
+	}
+
+	repo = &Repository{}
+	err = api.PostJSON(generateURL, params, repo)
+	return
+}
+
+// createRepositoryFromTemplateParams builds the POST .../generate request
+// body for CreateRepositoryFromTemplate.
+func createRepositoryFromTemplateParams(project *Project, isPrivate bool) map[string]interface{} {
+	return map[string]interface{}{
+		"owner":   project.Owner,
+		"name":    project.Name,
+		"private": isPrivate,
+	}
+}
+
+// StartImport kicks off GitHub's Source Import API for project, migrating
+// the history at vcsURL (of the given vcs, one of git, svn, hg or tfvc) into
+// the freshly created repository. username and password authenticate
+// against the source VCS and may be left blank for public repositories.
+func (client *Client) StartImport(project *Project, vcs, vcsURL, username, password string) (pi *Import, err error) {
+	params := startImportParams(vcs, vcsURL, username, password)
+
+	api, err := client.simpleApi()
+	if err != nil {
+		return
+	}
+
+	pi = &Import{}
+	err = api.PutJSON(fmt.Sprintf("repos/%s/%s/import", project.Owner, project.Name), params, pi)
+	return
+}
+
+// startImportParams builds the PUT .../import request body for StartImport.
+func startImportParams(vcs, vcsURL, username, password string) map[string]interface{} {
+	params := map[string]interface{}{
+		"vcs_url": vcsURL,
+	}
+	if vcs != "" {
+		params["vcs"] = vcs
+	}
+	if username != "" {
+		params["vcs_username"] = username
+	}
+	if password != "" {
+		params["vcs_password"] = password
+	}
+	return params
+}
+
+// ImportProgress polls the status of an import previously started with
+// StartImport.
+func (client *Client) ImportProgress(project *Project) (pi *Import, err error) {
+	api, err := client.simpleApi()
+	if err != nil {
+		return
+	}
+
+	pi = &Import{}
+	err = api.Get(fmt.Sprintf("repos/%s/%s/import", project.Owner, project.Name), pi)
+	return
+}
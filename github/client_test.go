@@ -0,0 +1,76 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCreateRepositoryParams(t *testing.T) {
+	project := NewProject("octocat", "hello-world", "github.com")
+
+	params := createRepositoryParams(project, "a description", "http://example.com", true, "", "", false)
+	want := map[string]interface{}{
+		"name":        "hello-world",
+		"description": "a description",
+		"homepage":    "http://example.com",
+		"private":     true,
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("createRepositoryParams() = %#v, want %#v", params, want)
+	}
+}
+
+func TestCreateRepositoryParamsWithTemplates(t *testing.T) {
+	project := NewProject("octocat", "hello-world", "github.com")
+
+	params := createRepositoryParams(project, "", "", false, "Go", "mit", true)
+	want := map[string]interface{}{
+		"name":               "hello-world",
+		"description":        "",
+		"homepage":           "",
+		"private":            false,
+		"gitignore_template": "Go",
+		"license_template":   "mit",
+		"auto_init":          true,
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("createRepositoryParams() = %#v, want %#v", params, want)
+	}
+}
+
+func TestCreateRepositoryFromTemplateParams(t *testing.T) {
+	project := NewProject("octocat", "new-project", "github.com")
+
+	params := createRepositoryFromTemplateParams(project, true)
+	want := map[string]interface{}{
+		"owner":   "octocat",
+		"name":    "new-project",
+		"private": true,
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("createRepositoryFromTemplateParams() = %#v, want %#v", params, want)
+	}
+}
+
+func TestStartImportParams(t *testing.T) {
+	params := startImportParams("", "https://svn.example.com/repo", "", "")
+	want := map[string]interface{}{
+		"vcs_url": "https://svn.example.com/repo",
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("startImportParams() = %#v, want %#v", params, want)
+	}
+}
+
+func TestStartImportParamsWithCredentials(t *testing.T) {
+	params := startImportParams("svn", "https://svn.example.com/repo", "bob", "hunter2")
+	want := map[string]interface{}{
+		"vcs_url":      "https://svn.example.com/repo",
+		"vcs":          "svn",
+		"vcs_username": "bob",
+		"vcs_password": "hunter2",
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("startImportParams() = %#v, want %#v", params, want)
+	}
+}
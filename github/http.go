@@ -0,0 +1,86 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// userAgent identifies this client to the GitHub API. The API rejects
+// requests with no User-Agent header at all.
+const userAgent = "Hub"
+
+// simpleClient is a minimal JSON-over-HTTP client for talking to the GitHub
+// API as a single authenticated host.
+type simpleClient struct {
+	httpClient *http.Client
+	rootURL    *url.URL
+	host       *Host
+}
+
+// Get issues a GET request against path and decodes the JSON response into
+// out.
+func (c *simpleClient) Get(path string, out interface{}) error {
+	return c.do("GET", path, nil, out)
+}
+
+// PostJSON issues a POST request against path with params encoded as the
+// JSON request body, decoding the JSON response into out.
+func (c *simpleClient) PostJSON(path string, params, out interface{}) error {
+	return c.do("POST", path, params, out)
+}
+
+// PutJSON issues a PUT request against path with params encoded as the JSON
+// request body, decoding the JSON response into out.
+func (c *simpleClient) PutJSON(path string, params, out interface{}) error {
+	return c.do("PUT", path, params, out)
+}
+
+func (c *simpleClient) do(method, path string, params, out interface{}) error {
+	endpoint, err := c.rootURL.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	body := &bytes.Buffer{}
+	if params != nil {
+		if err := json.NewEncoder(body).Encode(params); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, endpoint.String(), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("User-Agent", userAgent)
+	if c.host != nil && c.host.AccessToken != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("token %s", c.host.AccessToken))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s %s: %d %s", method, path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	if out != nil && len(bytes.TrimSpace(data)) > 0 {
+		return json.Unmarshal(data, out)
+	}
+	return nil
+}